@@ -0,0 +1,162 @@
+// Copyright 2014 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package round
+
+import "math/big"
+
+// BigInt returns the result of rounding v to the nearest multiple of n,
+// using the same half-up rule as Int64. If n <= 0, it returns a copy of v
+// unchanged. Unlike Int64, BigInt isn't limited to 19 decimal digits.
+func BigInt(v, n *big.Int) *big.Int {
+	return BigIntMode(v, n, HalfUp)
+}
+
+// BigIntMode returns the result of rounding v to the nearest multiple of n,
+// using mode m to break ties and to decide the direction when m doesn't
+// consider ties at all, the same as Int64Mode. If n <= 0, it returns a copy
+// of v unchanged.
+func BigIntMode(v, n *big.Int, m Mode) *big.Int {
+	if n.Sign() <= 0 {
+		return new(big.Int).Set(v)
+	}
+	neg := v.Sign() < 0
+	av := new(big.Int).Abs(v)
+	q, r := new(big.Int).QuoRem(av, n, new(big.Int))
+	if r.Sign() != 0 && bigIntRoundsUp(m, q, r, n, neg) {
+		q.Add(q, big.NewInt(1))
+	}
+	result := q.Mul(q, n)
+	if neg {
+		result.Neg(result)
+	}
+	return result
+}
+
+// bigIntRoundsUp reports whether a value with quotient q and nonzero
+// remainder r modulo n should round to q+1 rather than q, under mode m.
+// Unlike Mode.roundUp, it compares 2r against n directly: big.Int has no
+// fixed width to overflow.
+func bigIntRoundsUp(m Mode, q, r, n *big.Int, neg bool) bool {
+	switch m {
+	case Truncate:
+		return false
+	case Ceiling:
+		return !neg
+	case Floor:
+		return neg
+	case HalfEven:
+		switch c := new(big.Int).Lsh(r, 1).Cmp(n); {
+		case c < 0:
+			return false
+		case c > 0:
+			return true
+		default:
+			return q.Bit(0) != 0
+		}
+	case HalfDown, HalfTowardZero:
+		return new(big.Int).Lsh(r, 1).Cmp(n) > 0
+	default: // HalfUp, HalfAwayFromZero
+		return new(big.Int).Lsh(r, 1).Cmp(n) >= 0
+	}
+}
+
+// BigIntN returns the result of rounding v to n significant decimal
+// figures. If n <= 0, it returns a copy of v unchanged.
+func BigIntN(v *big.Int, n int) *big.Int {
+	return BigIntNMode(v, n, HalfUp)
+}
+
+// BigIntNMode returns the result of rounding v to n significant decimal
+// figures, using mode m to break ties. If n <= 0, it returns a copy of v
+// unchanged.
+func BigIntNMode(v *big.Int, n int, m Mode) *big.Int {
+	if n <= 0 {
+		return new(big.Int).Set(v)
+	}
+	digits := len(new(big.Int).Abs(v).Text(10))
+	if e := digits - n; e > 0 {
+		pow := new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(e)), nil)
+		return BigIntMode(v, pow, m)
+	}
+	return new(big.Int).Set(v)
+}
+
+// BigFloat returns the result of rounding v to the nearest multiple of n,
+// using the same half-up rule as Int64, at v's precision. If n <= 0 or v is
+// infinite, it returns a copy of v unchanged.
+func BigFloat(v, n *big.Float) *big.Float {
+	return BigFloatMode(v, n, HalfUp)
+}
+
+// BigFloatMode returns the result of rounding v to the nearest multiple of
+// n, using mode m to break ties and to decide the direction when m doesn't
+// consider ties at all, at v's precision. If n <= 0 or v is infinite, it
+// returns a copy of v unchanged.
+func BigFloatMode(v, n *big.Float, m Mode) *big.Float {
+	if n.Sign() <= 0 || v.IsInf() {
+		return new(big.Float).Copy(v)
+	}
+	neg := v.Sign() < 0
+	av := new(big.Float).Abs(v)
+	quotient := new(big.Float).Quo(av, n)
+	q, _ := quotient.Int(nil)
+	r := new(big.Float).Sub(quotient, new(big.Float).SetInt(q))
+	if r.Sign() != 0 && bigFloatRoundsUp(m, q, r, neg) {
+		q.Add(q, big.NewInt(1))
+	}
+	result := new(big.Float).SetPrec(v.Prec()).Mul(new(big.Float).SetInt(q), n)
+	if neg {
+		result.Neg(result)
+	}
+	return result
+}
+
+// bigFloatRoundsUp reports whether a value with integer quotient q and
+// fractional remainder r (0 <= r < 1) should round up to q+1 under mode m.
+func bigFloatRoundsUp(m Mode, q *big.Int, r *big.Float, neg bool) bool {
+	half := big.NewFloat(0.5)
+	switch m {
+	case Truncate:
+		return false
+	case Ceiling:
+		return !neg
+	case Floor:
+		return neg
+	case HalfEven:
+		switch c := r.Cmp(half); {
+		case c < 0:
+			return false
+		case c > 0:
+			return true
+		default:
+			return q.Bit(0) != 0
+		}
+	case HalfDown, HalfTowardZero:
+		return r.Cmp(half) > 0
+	default: // HalfUp, HalfAwayFromZero
+		return r.Cmp(half) >= 0
+	}
+}
+
+// BigFloatN returns the result of rounding v to n significant decimal
+// figures, at v's precision, using correctly-rounded decimal conversion. If
+// n <= 0, it returns a copy of v unchanged.
+//
+// BigFloatN has no Mode-taking counterpart: its decimal rounding is
+// performed by v.Text, which always rounds to nearest with ties to even,
+// and that rounding happens before the result is converted back to a
+// *big.Float, so there's no remainder left for a Mode to act on. Round to
+// an explicit multiple of n with BigFloatMode if another tie-break rule is
+// needed.
+func BigFloatN(v *big.Float, n int) *big.Float {
+	if n <= 0 {
+		return new(big.Float).Copy(v)
+	}
+	result, _, err := big.ParseFloat(v.Text('e', n-1), 10, v.Prec(), big.ToNearestEven)
+	if err != nil {
+		return new(big.Float).Copy(v)
+	}
+	return result
+}
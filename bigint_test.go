@@ -0,0 +1,118 @@
+// Copyright 2014 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package round
+
+import (
+	"math/big"
+	"testing"
+)
+
+func TestBigInt(t *testing.T) {
+	tests := []struct {
+		v, n, want int64
+	}{
+		{7, 2, 8},
+		{123, 10, 120},
+		{-420, 25, -425},
+	}
+	for _, tt := range tests {
+		got := BigInt(big.NewInt(tt.v), big.NewInt(tt.n))
+		if want := big.NewInt(tt.want); got.Cmp(want) != 0 {
+			t.Errorf("BigInt(%d, %d) = %v; want %v", tt.v, tt.n, got, want)
+		}
+	}
+}
+
+func TestBigIntN(t *testing.T) {
+	tests := []struct {
+		v    int64
+		n    int
+		want int64
+	}{
+		{12895, 2, 13000},
+		{-567, 2, -570},
+	}
+	for _, tt := range tests {
+		got := BigIntN(big.NewInt(tt.v), tt.n)
+		if want := big.NewInt(tt.want); got.Cmp(want) != 0 {
+			t.Errorf("BigIntN(%d, %d) = %v; want %v", tt.v, tt.n, got, want)
+		}
+	}
+}
+
+func TestBigFloat(t *testing.T) {
+	tests := []struct {
+		v, n, want float64
+	}{
+		{3.456, 0.1, 3.5},
+		{123.4, 10, 120},
+	}
+	for _, tt := range tests {
+		got := BigFloat(big.NewFloat(tt.v), big.NewFloat(tt.n))
+		want := big.NewFloat(tt.want)
+		if got.Cmp(want) != 0 {
+			t.Errorf("BigFloat(%v, %v) = %v; want %v", tt.v, tt.n, got, want)
+		}
+	}
+}
+
+func TestBigIntMode(t *testing.T) {
+	tests := []struct {
+		v, n int64
+		m    Mode
+		want int64
+	}{
+		{125, 10, HalfUp, 130},
+		{125, 10, HalfDown, 120},
+		{125, 10, HalfEven, 120}, // quotient 12 is already even
+		{135, 10, HalfEven, 140}, // quotient 13 is odd
+		{125, 10, Ceiling, 130},
+		{-125, 10, Ceiling, -120},
+		{125, 10, Floor, 120},
+		{125, 10, Truncate, 120},
+	}
+	for _, tt := range tests {
+		got := BigIntMode(big.NewInt(tt.v), big.NewInt(tt.n), tt.m)
+		if want := big.NewInt(tt.want); got.Cmp(want) != 0 {
+			t.Errorf("BigIntMode(%d, %d, %v) = %v; want %v", tt.v, tt.n, tt.m, got, want)
+		}
+	}
+}
+
+func TestBigFloatMode(t *testing.T) {
+	tests := []struct {
+		v, n float64
+		m    Mode
+		want float64
+	}{
+		{4.25, 0.5, HalfUp, 4.5},
+		{4.25, 0.5, HalfDown, 4.0},
+		{4.25, 0.5, HalfEven, 4.0}, // quotient 8 is already even
+		{3.75, 0.5, HalfEven, 4.0}, // quotient 7 is odd
+		{-4.25, 0.5, Ceiling, -4.0},
+		{4.25, 0.5, Floor, 4.0},
+		{4.25, 0.5, Truncate, 4.0},
+	}
+	for _, tt := range tests {
+		got := BigFloatMode(big.NewFloat(tt.v), big.NewFloat(tt.n), tt.m)
+		want := big.NewFloat(tt.want)
+		if got.Cmp(want) != 0 {
+			t.Errorf("BigFloatMode(%v, %v, %v) = %v; want %v", tt.v, tt.n, tt.m, got, want)
+		}
+	}
+}
+
+func TestBigFloatInf(t *testing.T) {
+	// BigFloat used to panic on an infinite v: Quo produced an infinite
+	// quotient, whose Int(nil) returns a nil *big.Int, which SetInt then
+	// dereferenced.
+	for _, sign := range []bool{false, true} {
+		v := new(big.Float).SetInf(sign)
+		got := BigFloat(v, big.NewFloat(2))
+		if !got.IsInf() || (got.Sign() < 0) != sign {
+			t.Errorf("BigFloat(%v, 2) = %v; want unchanged", v, got)
+		}
+	}
+}
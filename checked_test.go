@@ -0,0 +1,115 @@
+// Copyright 2014 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package round
+
+import (
+	"math"
+	"testing"
+	"time"
+)
+
+func TestInt64Checked(t *testing.T) {
+	tests := []struct {
+		v, n       int64
+		wantResult int64
+		wantOK     bool
+	}{
+		{7, 2, 8, true},
+		{-420, 25, -425, true},
+		// Large v and n whose remainders are themselves large enough that
+		// a naive r+r tie-break comparison overflows int64/uint64.
+		{4611686018427388002, 4611686018427388003, 4611686018427388003, true},
+		{-4611686018427388002, 4611686018427388003, -4611686018427388003, true},
+		// v+n-r overflows math.MaxInt64.
+		{math.MaxInt64 - 1, math.MaxInt64, math.MaxInt64, true},
+		{math.MaxInt64, 2, 0, false},
+		// v is math.MinInt64, whose magnitude isn't representable as int64:
+		// it's fine if it's already a multiple of n, but rounding it away
+		// from zero overflows.
+		{math.MinInt64, 2, math.MinInt64, true},
+		{math.MinInt64, 3, 0, false},
+		{math.MinInt64, 1, math.MinInt64, true},
+	}
+	for _, tt := range tests {
+		result, ok := Int64Checked(tt.v, tt.n)
+		if result != tt.wantResult || ok != tt.wantOK {
+			t.Errorf("Int64Checked(%d, %d) = (%d, %v); want (%d, %v)",
+				tt.v, tt.n, result, ok, tt.wantResult, tt.wantOK)
+		}
+	}
+}
+
+func TestInt64Clamped(t *testing.T) {
+	tests := []struct {
+		v, n int64
+		want int64
+	}{
+		{7, 2, 8},
+		{math.MaxInt64, 2, math.MaxInt64},
+		{math.MinInt64, 2, math.MinInt64},
+	}
+	for _, tt := range tests {
+		if got := Int64Clamped(tt.v, tt.n); got != tt.want {
+			t.Errorf("Int64Clamped(%d, %d) = %d; want %d", tt.v, tt.n, got, tt.want)
+		}
+	}
+}
+
+func TestUint64Checked(t *testing.T) {
+	tests := []struct {
+		v, n       uint64
+		wantResult uint64
+		wantOK     bool
+	}{
+		{7, 2, 8, true},
+		{420, 25, 425, true},
+		// Large v and n whose remainders are themselves large enough that
+		// a naive r+r tie-break comparison overflows uint64.
+		{9223372036854775906, 9223372036854775907, 9223372036854775907, true},
+		// v+n-r overflows math.MaxUint64.
+		{math.MaxUint64 - 1, math.MaxUint64, math.MaxUint64, true},
+		{math.MaxUint64, 2, 0, false},
+	}
+	for _, tt := range tests {
+		result, ok := Uint64Checked(tt.v, tt.n)
+		if result != tt.wantResult || ok != tt.wantOK {
+			t.Errorf("Uint64Checked(%d, %d) = (%d, %v); want (%d, %v)",
+				tt.v, tt.n, result, ok, tt.wantResult, tt.wantOK)
+		}
+	}
+}
+
+func TestUint64Clamped(t *testing.T) {
+	tests := []struct {
+		v, n uint64
+		want uint64
+	}{
+		{7, 2, 8},
+		{math.MaxUint64, 2, math.MaxUint64},
+	}
+	for _, tt := range tests {
+		if got := Uint64Clamped(tt.v, tt.n); got != tt.want {
+			t.Errorf("Uint64Clamped(%d, %d) = %d; want %d", tt.v, tt.n, got, tt.want)
+		}
+	}
+}
+
+func TestDurationChecked(t *testing.T) {
+	tests := []struct {
+		d, n       int64 // time.Duration values, as int64 nanoseconds
+		wantResult int64
+		wantOK     bool
+	}{
+		{int64(3 * second), int64(2 * second), int64(4 * second), true},
+		{math.MaxInt64, 2, 0, false},
+	}
+	for _, tt := range tests {
+		result, ok := DurationChecked(time.Duration(tt.d), time.Duration(tt.n))
+		if int64(result) != tt.wantResult || ok != tt.wantOK {
+			t.Errorf("DurationChecked(%d, %d) = (%d, %v); want (%d, %v)",
+				tt.d, tt.n, result, ok, tt.wantResult, tt.wantOK)
+		}
+	}
+}
@@ -0,0 +1,147 @@
+// Copyright 2014 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package round
+
+import "math"
+
+// Float64 returns the result of rounding v to the nearest multiple of n.
+// If n <= 0, v is NaN, or v is infinite, it returns v unchanged.
+//
+// Examples:
+//	Float64(3.456, 0.1)  // 3.5
+//	Float64(123.4, 10)   // 120
+//	Float64(-4.25, 0.5)  // -4.5
+func Float64(v, n float64) float64 {
+	return Float64Mode(v, n, HalfUp)
+}
+
+// Float64Mode returns the result of rounding v to the nearest multiple of n,
+// using mode m to break ties and to decide the direction when m doesn't
+// consider ties at all. If n <= 0, v is NaN, or v is infinite, it returns v
+// unchanged.
+func Float64Mode(v, n float64, m Mode) float64 {
+	if n <= 0 || v == 0 || math.IsNaN(v) || math.IsInf(v, 0) {
+		return v
+	}
+	neg := v < 0
+	if neg {
+		v = -v
+	}
+	q := math.Trunc(v / n)
+	r := v - q*n
+	if r != 0 && m.roundUpFloat(q, r, n, neg) {
+		q++
+	}
+	v = q * n
+	if neg {
+		return -v
+	}
+	return v
+}
+
+// Float64N returns the result of rounding v to n significant decimal
+// figures. If n <= 0, or v is zero, NaN, infinite, or subnormal, it returns
+// v unchanged.
+//
+// Examples:
+//	Float64N(12895.0, 2)  // 13000
+//	Float64N(0.012895, 2) // 0.013
+//	Float64N(-4213.0, 1)  // -4000
+func Float64N(v float64, n int) float64 {
+	return Float64NMode(v, n, HalfUp)
+}
+
+// Float64NMode returns the result of rounding v to n significant decimal
+// figures, using mode m to break ties. If n <= 0, or v is zero, NaN,
+// infinite, or subnormal, it returns v unchanged.
+func Float64NMode(v float64, n int, m Mode) float64 {
+	if n <= 0 || v == 0 || math.IsNaN(v) || math.IsInf(v, 0) {
+		return v
+	}
+	if isSubnormal(v) {
+		// math.Log10 overstates the exponent of a subnormal, so v/p would
+		// truncate to a quotient of 0 and round v down to 0.
+		return v
+	}
+	neg := v < 0
+	if neg {
+		v = -v
+	}
+	e := int(math.Floor(math.Log10(v))) - (n - 1)
+	if e < 0 && -e < len(pow10tab) {
+		// Divide by the exact integer 10**-e rather than multiplying by its
+		// inexact reciprocal, so that 13 * 10**-3 comes out as 0.013.
+		d := float64(pow10tab[-e])
+		q := math.Trunc(v * d)
+		if r := v*d - q; r != 0 && m.roundUpFloat(q, r, 1, neg) {
+			q++
+		}
+		v = q / d
+	} else {
+		p := f64pow10(e)
+		q := math.Trunc(v / p)
+		r := v/p - q
+		if r != 0 && m.roundUpFloat(q, r, 1, neg) {
+			q++
+		}
+		v = q * p
+	}
+	if neg {
+		return -v
+	}
+	return v
+}
+
+// Float32 returns the result of rounding v to the nearest multiple of n.
+// If n <= 0, v is NaN, or v is infinite, it returns v unchanged.
+//
+// Examples:
+//	Float32(3.456, 0.1)  // 3.5
+//	Float32(123.4, 10)   // 120
+func Float32(v, n float32) float32 {
+	return float32(Float64(float64(v), float64(n)))
+}
+
+// Float32Mode returns the result of rounding v to the nearest multiple of n,
+// using mode m to break ties and to decide the direction when m doesn't
+// consider ties at all. If n <= 0, v is NaN, or v is infinite, it returns v
+// unchanged.
+func Float32Mode(v, n float32, m Mode) float32 {
+	return float32(Float64Mode(float64(v), float64(n), m))
+}
+
+// Float32N returns the result of rounding v to n significant decimal
+// figures. If n <= 0, v is NaN, or v is infinite, it returns v unchanged.
+//
+// Examples:
+//	Float32N(12895.0, 2) // 13000
+func Float32N(v float32, n int) float32 {
+	return float32(Float64N(float64(v), n))
+}
+
+// Float32NMode returns the result of rounding v to n significant decimal
+// figures, using mode m to break ties. If n <= 0, v is NaN, or v is
+// infinite, it returns v unchanged.
+func Float32NMode(v float32, n int, m Mode) float32 {
+	return float32(Float64NMode(float64(v), n, m))
+}
+
+// isSubnormal reports whether v is a subnormal (denormalized) float64: too
+// small in magnitude to be represented with a normalized exponent.
+func isSubnormal(v float64) bool {
+	return v != 0 && math.Float64bits(v)&0x7ff0000000000000 == 0
+}
+
+// f64pow10 returns 10**e, using pow10tab for |e| <= 19 to avoid the
+// precision loss of math.Pow10 for exponents in that range.
+func f64pow10(e int) float64 {
+	if e >= 0 && e < len(pow10tab) {
+		return float64(pow10tab[e])
+	}
+	if e < 0 && -e < len(pow10tab) {
+		return 1 / float64(pow10tab[-e])
+	}
+	return math.Pow10(e)
+}
@@ -0,0 +1,102 @@
+// Copyright 2014 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package round
+
+import (
+	"math"
+	"testing"
+)
+
+func TestFloat64N(t *testing.T) {
+	tests := []struct {
+		v    float64
+		n    int
+		want float64
+	}{
+		{12895.0, 2, 13000},
+		{-4213.0, 1, -4000},
+		{0, 2, 0},
+		{math.NaN(), 2, math.NaN()},
+		{math.Inf(1), 2, math.Inf(1)},
+		{math.Inf(-1), 2, math.Inf(-1)},
+		// math.Log10 overstates the exponent of subnormals, which would
+		// otherwise silently round them to 0.
+		{math.SmallestNonzeroFloat64, 2, math.SmallestNonzeroFloat64},
+		{-math.SmallestNonzeroFloat64, 2, -math.SmallestNonzeroFloat64},
+	}
+	for _, tt := range tests {
+		got := Float64N(tt.v, tt.n)
+		if math.IsNaN(tt.want) {
+			if !math.IsNaN(got) {
+				t.Errorf("Float64N(%v, %d) = %v; want NaN", tt.v, tt.n, got)
+			}
+			continue
+		}
+		if got != tt.want {
+			t.Errorf("Float64N(%v, %d) = %v; want %v", tt.v, tt.n, got, tt.want)
+		}
+	}
+}
+
+func TestFloat64Mode(t *testing.T) {
+	tests := []struct {
+		v, n float64
+		m    Mode
+		want float64
+	}{
+		// 4.25/0.5 is an exact tie: quotient 8 is even.
+		{4.25, 0.5, HalfUp, 4.5},
+		{4.25, 0.5, HalfDown, 4.0},
+		{4.25, 0.5, HalfEven, 4.0},
+		{4.25, 0.5, Ceiling, 4.5},
+		{-4.25, 0.5, Ceiling, -4.0},
+		{4.25, 0.5, Floor, 4.0},
+		{4.25, 0.5, Truncate, 4.0},
+		// 3.75/0.5 is also an exact tie, but quotient 7 is odd.
+		{3.75, 0.5, HalfEven, 4.0},
+	}
+	for _, tt := range tests {
+		if got := Float64Mode(tt.v, tt.n, tt.m); got != tt.want {
+			t.Errorf("Float64Mode(%v, %v, %v) = %v; want %v", tt.v, tt.n, tt.m, got, tt.want)
+		}
+	}
+}
+
+func TestFloat64NMode(t *testing.T) {
+	tests := []struct {
+		v    float64
+		n    int
+		m    Mode
+		want float64
+	}{
+		{12895.0, 2, HalfUp, 13000},
+		{-4213.0, 1, Floor, -5000},
+		{4213.0, 1, Ceiling, 5000},
+	}
+	for _, tt := range tests {
+		if got := Float64NMode(tt.v, tt.n, tt.m); got != tt.want {
+			t.Errorf("Float64NMode(%v, %d, %v) = %v; want %v", tt.v, tt.n, tt.m, got, tt.want)
+		}
+	}
+}
+
+func TestIsSubnormal(t *testing.T) {
+	tests := []struct {
+		v    float64
+		want bool
+	}{
+		{0, false},
+		{1, false},
+		{math.SmallestNonzeroFloat64, true},
+		{-math.SmallestNonzeroFloat64, true},
+		{math.Inf(1), false},
+		{math.NaN(), false},
+	}
+	for _, tt := range tests {
+		if got := isSubnormal(tt.v); got != tt.want {
+			t.Errorf("isSubnormal(%v) = %v; want %v", tt.v, got, tt.want)
+		}
+	}
+}
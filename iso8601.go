@@ -0,0 +1,126 @@
+// Copyright 2014 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package round
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// FormatISO8601 returns the ISO 8601 representation of d, such as
+// "PT3H25M45.5S" for 3h25m45.5s or "-PT1M30S" for -1m30s. It composes
+// naturally with the rounding functions in this package, e.g.
+// round.FormatISO8601(round.DurationN(d, 2)).
+func FormatISO8601(d time.Duration) string {
+	return string(AppendISO8601(make([]byte, 0, 32), d))
+}
+
+// AppendISO8601 appends the ISO 8601 representation of d, as returned by
+// FormatISO8601, to b and returns the extended buffer.
+func AppendISO8601(b []byte, d time.Duration) []byte {
+	// Operate on the unsigned magnitude of d, the way the time package's own
+	// Duration formatting does, since -d overflows when d is math.MinInt64.
+	u := uint64(d)
+	if d < 0 {
+		b = append(b, '-')
+		u = -u
+	}
+	b = append(b, 'P', 'T')
+	start := len(b)
+	if h := u / uint64(time.Hour); h > 0 {
+		b = strconv.AppendUint(b, h, 10)
+		b = append(b, 'H')
+		u -= h * uint64(time.Hour)
+	}
+	if m := u / uint64(time.Minute); m > 0 {
+		b = strconv.AppendUint(b, m, 10)
+		b = append(b, 'M')
+		u -= m * uint64(time.Minute)
+	}
+	if u > 0 || len(b) == start {
+		sec := float64(u) / float64(time.Second)
+		b = strconv.AppendFloat(b, sec, 'f', -1, 64)
+		b = append(b, 'S')
+	}
+	return b
+}
+
+// ParseISO8601 parses an ISO 8601 duration string, such as "PT3H25M45.5S" or
+// "P1DT30M", into a time.Duration. Weeks ("W") and days ("D") are accepted
+// as the date portion but FormatISO8601 never produces them, expressing
+// entire durations in the time portion instead.
+func ParseISO8601(s string) (time.Duration, error) {
+	orig, rest := s, s
+	neg := false
+	if len(rest) > 0 && (rest[0] == '-' || rest[0] == '+') {
+		neg = rest[0] == '-'
+		rest = rest[1:]
+	}
+	if len(rest) == 0 || rest[0] != 'P' {
+		return 0, fmt.Errorf("round: invalid ISO 8601 duration %q", orig)
+	}
+	rest = rest[1:]
+	datePart, timePart := rest, ""
+	if i := strings.IndexByte(rest, 'T'); i >= 0 {
+		datePart, timePart = rest[:i], rest[i+1:]
+	}
+	if datePart == "" && timePart == "" {
+		return 0, fmt.Errorf("round: invalid ISO 8601 duration %q", orig)
+	}
+	var d time.Duration
+	if err := parseISO8601Units(datePart, map[byte]time.Duration{'W': 7 * 24 * time.Hour, 'D': 24 * time.Hour}, &d); err != nil {
+		return 0, fmt.Errorf("round: invalid ISO 8601 duration %q: %w", orig, err)
+	}
+	if err := parseISO8601Units(timePart, map[byte]time.Duration{'H': time.Hour, 'M': time.Minute, 'S': time.Second}, &d); err != nil {
+		return 0, fmt.Errorf("round: invalid ISO 8601 duration %q: %w", orig, err)
+	}
+	if neg {
+		d = -d
+	}
+	return d, nil
+}
+
+// parseISO8601Units parses a sequence of <number><unit> components from
+// part, where unit is a key of units, and accumulates the result into d.
+// Only the seconds component ('S') may be fractional, per ISO 8601; it's
+// parsed as a float, while every other component is parsed as an integer
+// so that large H/M/D/W values don't lose precision in a float64 mantissa.
+func parseISO8601Units(part string, units map[byte]time.Duration, d *time.Duration) error {
+	for len(part) > 0 {
+		i := 0
+		for i < len(part) && (part[i] == '.' || (part[i] >= '0' && part[i] <= '9')) {
+			i++
+		}
+		if i == 0 || i >= len(part) {
+			return fmt.Errorf("malformed component %q", part)
+		}
+		unit := part[i]
+		mult, ok := units[unit]
+		if !ok {
+			return fmt.Errorf("unexpected unit %q", unit)
+		}
+		numStr := part[:i]
+		if unit == 'S' {
+			f, err := strconv.ParseFloat(numStr, 64)
+			if err != nil {
+				return err
+			}
+			// Round rather than truncate: f*float64(mult) lands a hair below
+			// the true integer nanosecond count as often as above it.
+			*d += time.Duration(math.Round(f * float64(mult)))
+		} else {
+			n, err := strconv.ParseInt(numStr, 10, 64)
+			if err != nil {
+				return err
+			}
+			*d += time.Duration(n) * mult
+		}
+		part = part[i+1:]
+	}
+	return nil
+}
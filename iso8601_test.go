@@ -0,0 +1,94 @@
+// Copyright 2014 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package round
+
+import (
+	"math"
+	"math/rand"
+	"testing"
+	"time"
+)
+
+func TestFormatISO8601(t *testing.T) {
+	tests := []struct {
+		d    time.Duration
+		want string
+	}{
+		{3*time.Hour + 25*time.Minute + 45500*time.Millisecond, "PT3H25M45.5S"},
+		{-(1*time.Minute + 30*time.Second), "-PT1M30S"},
+		{0, "PT0S"},
+		// math.MinInt64 has no positive int64 counterpart, so -d overflows;
+		// FormatISO8601 must still produce a single leading '-' with the
+		// correct magnitude.
+		{time.Duration(math.MinInt64), "-PT2562047H47M16.854775808S"},
+	}
+	for _, tt := range tests {
+		if got := FormatISO8601(tt.d); got != tt.want {
+			t.Errorf("FormatISO8601(%d) = %q; want %q", tt.d, got, tt.want)
+		}
+	}
+}
+
+func TestParseISO8601(t *testing.T) {
+	tests := []struct {
+		s    string
+		want time.Duration
+	}{
+		{"PT3H25M45.5S", 3*time.Hour + 25*time.Minute + 45500*time.Millisecond},
+		{"-PT1M30S", -(1*time.Minute + 30*time.Second)},
+		{"P1W", 7 * 24 * time.Hour},
+		{"P1DT30M", 24*time.Hour + 30*time.Minute},
+	}
+	for _, tt := range tests {
+		got, err := ParseISO8601(tt.s)
+		if err != nil {
+			t.Errorf("ParseISO8601(%q) returned error: %v", tt.s, err)
+			continue
+		}
+		if got != tt.want {
+			t.Errorf("ParseISO8601(%q) = %v; want %v", tt.s, got, tt.want)
+		}
+	}
+}
+
+func TestISO8601RoundTrip(t *testing.T) {
+	for _, d := range []time.Duration{
+		3*time.Hour + 25*time.Minute + 45500*time.Millisecond,
+		-(1*time.Minute + 30*time.Second),
+		time.Duration(math.MinInt64),
+		time.Duration(math.MaxInt64),
+		// A large hour component: parsing it as a float64 (rather than an
+		// integer) used to lose a nanosecond of precision.
+		1462092*time.Hour + 12*time.Minute + 16693774911*time.Nanosecond,
+	} {
+		s := FormatISO8601(d)
+		got, err := ParseISO8601(s)
+		if err != nil {
+			t.Errorf("ParseISO8601(FormatISO8601(%d)) = ParseISO8601(%q) returned error: %v", d, s, err)
+			continue
+		}
+		if got != d {
+			t.Errorf("ParseISO8601(FormatISO8601(%d)) = %v; want %v", d, got, d)
+		}
+	}
+}
+
+func TestISO8601RoundTripRandom(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	for i := 0; i < 10000; i++ {
+		d := time.Duration(r.Int63())
+		if r.Intn(2) == 0 {
+			d = -d
+		}
+		s := FormatISO8601(d)
+		got, err := ParseISO8601(s)
+		if err != nil {
+			t.Fatalf("ParseISO8601(FormatISO8601(%d)) = ParseISO8601(%q) returned error: %v", d, s, err)
+		}
+		if got != d {
+			t.Fatalf("ParseISO8601(FormatISO8601(%d)) = %v; want %v (formatted as %q)", d, got, d, s)
+		}
+	}
+}
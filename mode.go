@@ -0,0 +1,108 @@
+// Copyright 2014 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package round
+
+// Mode identifies a rounding rule: how to choose between the two multiples
+// of n that bracket a value, and which one to prefer when the value falls
+// exactly halfway between them.
+type Mode int
+
+const (
+	// HalfUp rounds to the nearest bracketing multiple, breaking ties
+	// toward the one with the larger magnitude (away from zero). It's the
+	// default used by the plain (non-Mode) functions in this package, such
+	// as Int64 and Duration.
+	HalfUp Mode = iota
+	// HalfDown rounds to the nearest bracketing multiple, breaking ties
+	// toward the one with the smaller magnitude (toward zero).
+	HalfDown
+	// HalfEven rounds to the nearest bracketing multiple, breaking ties
+	// toward whichever multiple has an even quotient (a.k.a. banker's
+	// rounding).
+	HalfEven
+	// HalfAwayFromZero breaks ties away from zero. It's equivalent to
+	// HalfUp.
+	HalfAwayFromZero
+	// HalfTowardZero breaks ties toward zero. It's equivalent to HalfDown.
+	HalfTowardZero
+	// Ceiling always rounds toward positive infinity.
+	Ceiling
+	// Floor always rounds toward negative infinity.
+	Floor
+	// Truncate always rounds toward zero.
+	Truncate
+)
+
+// negated returns the mode that produces the same result as m when applied
+// to -v instead of v. Ceiling and Floor are direction-sensitive and swap;
+// the rest operate on magnitude alone and are returned unchanged.
+func (m Mode) negated() Mode {
+	switch m {
+	case Ceiling:
+		return Floor
+	case Floor:
+		return Ceiling
+	default:
+		return m
+	}
+}
+
+// roundUp reports whether v should round to the bracketing multiple with
+// the larger magnitude (v+n-r) rather than the smaller one (v-r), given
+// that v's remainder modulo n is r and v was negative before neg caused it
+// to be made non-negative. It's called only when r != 0.
+func (m Mode) roundUp(v, n, r uint64, neg bool) bool {
+	// Comparisons are written as r vs. n-r, rather than r+r vs. n, so that
+	// they can't overflow when r and n are within a bit of the uint64 max.
+	switch m {
+	case Truncate:
+		return false
+	case Ceiling:
+		return !neg
+	case Floor:
+		return neg
+	case HalfEven:
+		switch {
+		case r < n-r:
+			return false
+		case r > n-r:
+			return true
+		default:
+			return ((v-r)/n)%2 != 0
+		}
+	case HalfDown, HalfTowardZero:
+		return r > n-r
+	default: // HalfUp, HalfAwayFromZero
+		return r >= n-r
+	}
+}
+
+// roundUpFloat is roundUp's float64 counterpart: v's remainder modulo n is
+// r, with integer quotient q = (v-r)/n. Floats don't overflow the way
+// fixed-width integers do, so there's no need for roundUp's r-vs-n-r
+// rewrite; r is compared against n directly.
+func (m Mode) roundUpFloat(q, r, n float64, neg bool) bool {
+	switch m {
+	case Truncate:
+		return false
+	case Ceiling:
+		return !neg
+	case Floor:
+		return neg
+	case HalfEven:
+		switch {
+		case r+r < n:
+			return false
+		case r+r > n:
+			return true
+		default:
+			return int64(q)%2 != 0
+		}
+	case HalfDown, HalfTowardZero:
+		return r+r > n
+	default: // HalfUp, HalfAwayFromZero
+		return r+r >= n
+	}
+}
@@ -0,0 +1,130 @@
+// Copyright 2014 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package round
+
+import (
+	"testing"
+	"time"
+)
+
+func TestInt64Mode(t *testing.T) {
+	tests := []struct {
+		v, n int64
+		m    Mode
+		want int64
+	}{
+		// r+r < n: every mode but Ceiling/Floor rounds down; Ceiling/Floor
+		// depend only on sign.
+		{12, 10, HalfUp, 10},
+		{12, 10, HalfDown, 10},
+		{12, 10, HalfEven, 10},
+		{12, 10, HalfAwayFromZero, 10},
+		{12, 10, HalfTowardZero, 10},
+		{12, 10, Truncate, 10},
+		{12, 10, Ceiling, 20},
+		{12, 10, Floor, 10},
+		{-12, 10, Ceiling, -10},
+		{-12, 10, Floor, -20},
+		// r+r > n: every mode but Floor/Truncate rounds up.
+		{18, 10, HalfUp, 20},
+		{18, 10, HalfDown, 20},
+		{18, 10, HalfEven, 20},
+		{18, 10, Truncate, 10},
+		{-18, 10, Ceiling, -10},
+		{-18, 10, Floor, -20},
+		// r+r == n: an exact tie, where the modes diverge.
+		{15, 10, HalfUp, 20},
+		{15, 10, HalfAwayFromZero, 20},
+		{15, 10, HalfDown, 10},
+		{15, 10, HalfTowardZero, 10},
+		{25, 10, HalfEven, 20}, // quotient 2 is already even
+		{15, 10, HalfEven, 20}, // quotient 1 is odd; round to 2
+		{-15, 10, HalfUp, -20},
+		{-15, 10, HalfDown, -10},
+		{-15, 10, Ceiling, -10},
+		{-15, 10, Floor, -20},
+		{-15, 10, Truncate, -10},
+	}
+	for _, tt := range tests {
+		if got := Int64Mode(tt.v, tt.n, tt.m); got != tt.want {
+			t.Errorf("Int64Mode(%d, %d, %d) = %d; want %d", tt.v, tt.n, tt.m, got, tt.want)
+		}
+	}
+}
+
+func TestUint64Mode(t *testing.T) {
+	tests := []struct {
+		v, n uint64
+		m    Mode
+		want uint64
+	}{
+		{12, 10, HalfUp, 10},
+		{12, 10, Ceiling, 20},
+		{12, 10, Floor, 10},
+		{18, 10, Truncate, 10},
+		{15, 10, HalfUp, 20},
+		{15, 10, HalfDown, 10},
+		{25, 10, HalfEven, 20},
+		{15, 10, HalfEven, 20},
+		{15, 10, Ceiling, 20},
+		{15, 10, Floor, 10},
+	}
+	for _, tt := range tests {
+		if got := Uint64Mode(tt.v, tt.n, tt.m); got != tt.want {
+			t.Errorf("Uint64Mode(%d, %d, %d) = %d; want %d", tt.v, tt.n, tt.m, got, tt.want)
+		}
+	}
+}
+
+func TestInt64NMode(t *testing.T) {
+	tests := []struct {
+		v    int64
+		n    int
+		m    Mode
+		want int64
+	}{
+		{12895, 2, HalfUp, 13000},
+		{125, 2, HalfEven, 120}, // tie rounds down: quotient 12 is already even
+		{135, 2, HalfEven, 140}, // tie rounds up: quotient 13 is odd
+		{-567, 2, HalfUp, -570},
+		{-567, 2, Floor, -570},
+		{567, 2, Ceiling, 570},
+	}
+	for _, tt := range tests {
+		if got := Int64NMode(tt.v, tt.n, tt.m); got != tt.want {
+			t.Errorf("Int64NMode(%d, %d, %d) = %d; want %d", tt.v, tt.n, tt.m, got, tt.want)
+		}
+	}
+}
+
+func TestModeNegated(t *testing.T) {
+	tests := []struct {
+		m    Mode
+		want Mode
+	}{
+		{Ceiling, Floor},
+		{Floor, Ceiling},
+		{HalfUp, HalfUp},
+		{HalfEven, HalfEven},
+		{Truncate, Truncate},
+	}
+	for _, tt := range tests {
+		if got := tt.m.negated(); got != tt.want {
+			t.Errorf("%v.negated() = %v; want %v", tt.m, got, tt.want)
+		}
+	}
+}
+
+func TestDurationNModeNegative(t *testing.T) {
+	// Ceiling/Floor must flip direction relative to the true sign of d, not
+	// the sign of the positive magnitude DurationNMode recurses on.
+	d := -(1*time.Hour + 35*time.Minute + 42567*time.Millisecond)
+	if got, want := DurationNMode(d, 2, Ceiling), -(1*time.Hour + 30*time.Minute); got != want {
+		t.Errorf("DurationNMode(%v, 2, Ceiling) = %v; want %v", d, got, want)
+	}
+	if got, want := DurationNMode(d, 2, Floor), -(1*time.Hour + 40*time.Minute); got != want {
+		t.Errorf("DurationNMode(%v, 2, Floor) = %v; want %v", d, got, want)
+	}
+}
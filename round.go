@@ -7,7 +7,10 @@
 // The behavior for halfway values is to round up.
 package round
 
-import "time"
+import (
+	"math"
+	"time"
+)
 
 const (
 	hour   = int64(time.Hour)
@@ -34,7 +37,23 @@ func init() {
 //	Duration("34.56789s", "1ms")   // "34.568s"
 //	Duration("-1m30s", "1m0s")     // "-2m0s"
 func Duration(d, n time.Duration) time.Duration {
-	return time.Duration(Int64(int64(d), int64(n)))
+	return DurationMode(d, n, HalfUp)
+}
+
+// DurationMode returns the result of rounding d to the nearest multiple of n,
+// using mode m to break ties and to decide the direction when m doesn't
+// consider ties at all. If n <= 1, it returns d unchanged.
+func DurationMode(d, n time.Duration, m Mode) time.Duration {
+	return time.Duration(Int64Mode(int64(d), int64(n), m))
+}
+
+// DurationChecked returns the result of rounding d to the nearest multiple
+// of n, using the HalfUp rule, and ok=false if the result would wrap past
+// math.MinInt64 or math.MaxInt64 nanoseconds instead of overflowing
+// silently. If n <= 1, it returns d, true unchanged.
+func DurationChecked(d, n time.Duration) (time.Duration, bool) {
+	result, ok := Int64Checked(int64(d), int64(n))
+	return time.Duration(result), ok
 }
 
 // DurationN returns the result of rounding d to n significant decimal figures
@@ -50,33 +69,72 @@ func Duration(d, n time.Duration) time.Duration {
 //	DurationN("1.567ms", 3)      // "1.57ms"
 //	DurationN("-41.5ms", 2)      // "-42ms"
 func DurationN(d time.Duration, n int) time.Duration {
+	return DurationNMode(d, n, HalfUp)
+}
+
+// DurationNMode returns the result of rounding d to n significant decimal
+// figures for standard string formatting, using mode m to break ties. If
+// n <= 0, it returns d unchanged.
+func DurationNMode(d time.Duration, n int, m Mode) time.Duration {
 	if n <= 0 {
 		return d
 	}
 	if d < 0 {
-		return -DurationN(-d, n)
+		return -DurationNMode(-d, n, m.negated())
 	}
 	v := int64(d)
 	if v >= hour {
 		k := i64digits(v / hour)
 		if k >= n {
-			return time.Duration(Int64(v, i64pow10(hour, k-n)))
+			return time.Duration(Int64Mode(v, i64pow10(hour, k-n), m))
 		}
 		n -= k
 		k = i64digits(v % hour / minute)
 		if k >= n {
-			return time.Duration(Int64(v, i64pow10(minute, k-n)))
+			return time.Duration(Int64Mode(v, i64pow10(minute, k-n), m))
 		}
-		return time.Duration(Int64(v, i64pow10(100*second, k-n)))
+		return time.Duration(Int64Mode(v, i64pow10(100*second, k-n), m))
 	}
 	if v >= minute {
 		k := i64digits(v / minute)
 		if k >= n {
-			return time.Duration(Int64(v, i64pow10(minute, k-n)))
+			return time.Duration(Int64Mode(v, i64pow10(minute, k-n), m))
 		}
-		return time.Duration(Int64(v, i64pow10(100*second, k-n)))
+		return time.Duration(Int64Mode(v, i64pow10(100*second, k-n), m))
+	}
+	return time.Duration(Int64NMode(v, n, m))
+}
+
+// Time returns the result of rounding t to the nearest multiple of n,
+// measured as an absolute duration since the Unix epoch, using the same
+// half-up rule as Duration. If n <= 1, it returns t unchanged.
+//
+// Examples:
+//	// time.Time values represented as strings for ease of understanding
+//	Time("2009-11-10T23:00:00.7Z", 500*time.Millisecond) // "2009-11-10T23:00:00.5Z"
+//	Time("2009-11-10T23:00:00.3Z", time.Second)          // "2009-11-10T23:00:00Z"
+func Time(t time.Time, n time.Duration) time.Time {
+	if n <= 1 {
+		return t
 	}
-	return time.Duration(Int64N(v, n))
+	return time.Unix(0, Int64(t.UnixNano(), int64(n))).In(t.Location())
+}
+
+// TimeN returns the result of rounding the clock components of t (the hour,
+// minute, second, and sub-second fields) to n significant decimal figures,
+// using the same rule as DurationN. The date and location of t are left
+// unchanged. If n <= 0, it returns t unchanged.
+//
+// Examples:
+//	// time.Time values represented as strings for ease of understanding
+//	TimeN("2009-11-10T15:35:42.567Z", 3) // "2009-11-10T15:40:00Z"
+//	TimeN("2009-11-10T15:35:42.567Z", 4) // "2009-11-10T15:36:00Z"
+func TimeN(t time.Time, n int) time.Time {
+	if n <= 0 {
+		return t
+	}
+	start := time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, t.Location())
+	return start.Add(DurationN(t.Sub(start), n))
 }
 
 // Int64 returns the result of rounding v to the nearest multiple of n.
@@ -87,6 +145,13 @@ func DurationN(d time.Duration, n int) time.Duration {
 //	Int64(123, 10)  // 120
 //	Int64(-420, 25) // -425
 func Int64(v, n int64) int64 {
+	return Int64Mode(v, n, HalfUp)
+}
+
+// Int64Mode returns the result of rounding v to the nearest multiple of n,
+// using mode m to break ties and to decide the direction when m doesn't
+// consider ties at all. If n <= 1, it returns v unchanged.
+func Int64Mode(v, n int64, m Mode) int64 {
 	if n <= 1 {
 		return v
 	}
@@ -94,10 +159,17 @@ func Int64(v, n int64) int64 {
 	if neg {
 		v = -v
 	}
-	if r := v % n; r+r < n {
-		v = v - r
-	} else {
+	r := v % n
+	if r == 0 {
+		if neg {
+			return -v
+		}
+		return v
+	}
+	if m.roundUp(uint64(v), uint64(n), uint64(r), neg) {
 		v = v + n - r
+	} else {
+		v = v - r
 	}
 	if neg {
 		return -v
@@ -113,15 +185,72 @@ func Int64(v, n int64) int64 {
 //	Int64N(4213, 1)  // 4000
 //	Int64N(-567, 2)  // -570
 func Int64N(v int64, n int) int64 {
+	return Int64NMode(v, n, HalfUp)
+}
+
+// Int64NMode returns the result of rounding v to n significant decimal
+// figures, using mode m to break ties. If n <= 0, it returns v unchanged.
+func Int64NMode(v int64, n int, m Mode) int64 {
 	if n <= 0 {
 		return v
 	}
 	if e := i64digits(v) - n; e > 0 {
-		return Int64(v, i64pow10(1, e))
+		return Int64Mode(v, i64pow10(1, e), m)
 	}
 	return v
 }
 
+// Int64Checked returns the result of rounding v to the nearest multiple of n,
+// using the HalfUp rule, and ok=false if the result would overflow int64
+// instead of wrapping silently. If n <= 1, it returns v, true unchanged.
+func Int64Checked(v, n int64) (result int64, ok bool) {
+	if n <= 1 {
+		return v, true
+	}
+	neg := v < 0
+	if neg {
+		if v == math.MinInt64 {
+			// -v overflows, so round using the remainder's magnitude
+			// directly: only rounding toward zero stays in range.
+			r := -(v % n)
+			if r == 0 {
+				return v, true
+			}
+			if r < n-r {
+				return v + r, true
+			}
+			return 0, false
+		}
+		v = -v
+	}
+	r := v % n
+	if r < n-r {
+		v = v - r
+	} else {
+		if n-r > math.MaxInt64-v {
+			return 0, false
+		}
+		v = v + n - r
+	}
+	if neg {
+		return -v, true
+	}
+	return v, true
+}
+
+// Int64Clamped returns the result of rounding v to the nearest multiple of
+// n, using the HalfUp rule, saturating to math.MinInt64 or math.MaxInt64
+// instead of overflowing. If n <= 1, it returns v unchanged.
+func Int64Clamped(v, n int64) int64 {
+	if result, ok := Int64Checked(v, n); ok {
+		return result
+	}
+	if v < 0 {
+		return math.MinInt64
+	}
+	return math.MaxInt64
+}
+
 // Uint64 returns the result of rounding v to the nearest multiple of n.
 // If n <= 1, it returns v unchanged.
 //
@@ -130,14 +259,52 @@ func Int64N(v int64, n int) int64 {
 //	Int64(123, 10) // 120
 //	Int64(420, 25) // 425
 func Uint64(v, n uint64) uint64 {
+	return Uint64Mode(v, n, HalfUp)
+}
+
+// Uint64Mode returns the result of rounding v to the nearest multiple of n,
+// using mode m to break ties and to decide the direction when m doesn't
+// consider ties at all. If n <= 1, it returns v unchanged.
+func Uint64Mode(v, n uint64, m Mode) uint64 {
 	if n <= 1 {
 		return v
 	}
 	r := v % n
-	if r+r < n {
-		return v - r
+	if r == 0 {
+		return v
+	}
+	if m.roundUp(v, n, r, false) {
+		return v + n - r
 	}
-	return v + n - r
+	return v - r
+}
+
+// Uint64Checked returns the result of rounding v to the nearest multiple of
+// n, using the HalfUp rule, and ok=false if the result would overflow
+// uint64 instead of wrapping silently. If n <= 1, it returns v, true
+// unchanged.
+func Uint64Checked(v, n uint64) (result uint64, ok bool) {
+	if n <= 1 {
+		return v, true
+	}
+	r := v % n
+	if r < n-r {
+		return v - r, true
+	}
+	if n-r > math.MaxUint64-v {
+		return 0, false
+	}
+	return v + n - r, true
+}
+
+// Uint64Clamped returns the result of rounding v to the nearest multiple of
+// n, using the HalfUp rule, saturating to math.MaxUint64 instead of
+// overflowing. If n <= 1, it returns v unchanged.
+func Uint64Clamped(v, n uint64) uint64 {
+	if result, ok := Uint64Checked(v, n); ok {
+		return result
+	}
+	return math.MaxUint64
 }
 
 // Uint64N returns the result of rounding v to n significant decimal figures.
@@ -147,11 +314,17 @@ func Uint64(v, n uint64) uint64 {
 //	Uint64N(12895, 2) // 13000
 //	Uint64N(4213, 1)  // 4000
 func Uint64N(v uint64, n int) uint64 {
+	return Uint64NMode(v, n, HalfUp)
+}
+
+// Uint64NMode returns the result of rounding v to n significant decimal
+// figures, using mode m to break ties. If n <= 0, it returns v unchanged.
+func Uint64NMode(v uint64, n int, m Mode) uint64 {
 	if n <= 0 {
 		return v
 	}
 	if e := u64digits(v) - n; e > 0 {
-		return Uint64(v, u64pow10(1, e))
+		return Uint64Mode(v, u64pow10(1, e), m)
 	}
 	return v
 }
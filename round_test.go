@@ -0,0 +1,55 @@
+// Copyright 2014 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package round
+
+import (
+	"testing"
+	"time"
+)
+
+func TestTime(t *testing.T) {
+	loc := time.FixedZone("TEST", -5*3600)
+	tests := []struct {
+		t    time.Time
+		n    time.Duration
+		want time.Time
+	}{
+		// n <= 1 passes t through unchanged, location included.
+		{time.Date(2009, 11, 10, 23, 0, 0, 700000000, loc), 0, time.Date(2009, 11, 10, 23, 0, 0, 700000000, loc)},
+		{time.Date(2009, 11, 10, 23, 0, 0, 700000000, loc), 1, time.Date(2009, 11, 10, 23, 0, 0, 700000000, loc)},
+		// Half-up tie (relative to the Unix epoch) rounds away from zero.
+		{time.Date(2009, 11, 10, 23, 0, 1, 0, time.UTC), 2 * time.Second, time.Date(2009, 11, 10, 23, 0, 2, 0, time.UTC)},
+		{time.Date(2009, 11, 10, 23, 0, 0, 300000000, time.UTC), time.Second, time.Date(2009, 11, 10, 23, 0, 0, 0, time.UTC)},
+	}
+	for _, tt := range tests {
+		got := Time(tt.t, tt.n)
+		if !got.Equal(tt.want) || got.Location() != tt.t.Location() {
+			t.Errorf("Time(%v, %v) = %v; want %v", tt.t, tt.n, got, tt.want)
+		}
+	}
+}
+
+func TestTimeN(t *testing.T) {
+	loc := time.FixedZone("TEST", -5*3600)
+	tests := []struct {
+		t    time.Time
+		n    int
+		want time.Time
+	}{
+		// n <= 0 passes t through unchanged.
+		{time.Date(2009, 11, 10, 15, 35, 42, 567000000, loc), 0, time.Date(2009, 11, 10, 15, 35, 42, 567000000, loc)},
+		{time.Date(2009, 11, 10, 15, 35, 42, 567000000, loc), -1, time.Date(2009, 11, 10, 15, 35, 42, 567000000, loc)},
+		// The clock fields round the same way DurationN rounds the time
+		// since midnight in t's own location, which is left unchanged.
+		{time.Date(2009, 11, 10, 15, 35, 42, 567000000, loc), 3, time.Date(2009, 11, 10, 15, 40, 0, 0, loc)},
+		{time.Date(2009, 11, 10, 15, 35, 42, 567000000, loc), 4, time.Date(2009, 11, 10, 15, 36, 0, 0, loc)},
+	}
+	for _, tt := range tests {
+		got := TimeN(tt.t, tt.n)
+		if !got.Equal(tt.want) || got.Location() != tt.t.Location() {
+			t.Errorf("TimeN(%v, %d) = %v; want %v", tt.t, tt.n, got, tt.want)
+		}
+	}
+}